@@ -0,0 +1,36 @@
+package ers
+
+// Causer is implemented by errors that can report the error they stem from.
+// It mirrors the convention popularized by github.com/pkg/errors, letting
+// callers walk a wrap chain without knowing its concrete depth.
+type Causer interface {
+	Cause() error
+}
+
+// Cause returns the immediately wrapped error, implementing the Causer
+// interface so Cause(err) can walk a wrap chain one level at a time.
+func (e *Error) Cause() error {
+	return e.error
+}
+
+// Cause unwraps err repeatedly through the Causer interface and returns the
+// deepest error reached, i.e. the first one that no longer implements
+// Causer. If err does not implement Causer, err itself is returned.
+func Cause(err error) error {
+	for {
+		causer, ok := err.(Causer)
+		if !ok {
+			return err
+		}
+		cause := causer.Cause()
+		if cause == nil {
+			return err
+		}
+		err = cause
+	}
+}
+
+// Root returns the deepest cause in e's wrap chain, equivalent to Cause(e).
+func (e *Error) Root() error {
+	return Cause(e)
+}
@@ -0,0 +1,42 @@
+package ers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCause(t *testing.T) {
+	base := errors.New("root cause")
+	wrapped := Wrap(base, "context")
+	rewrapped := Wrap(wrapped, "more context")
+
+	if got := Cause(rewrapped); got != base {
+		t.Errorf("Cause() = %v, want %v", got, base)
+	}
+}
+
+func TestCause_NonCauser(t *testing.T) {
+	base := errors.New("plain error")
+
+	if got := Cause(base); got != base {
+		t.Errorf("Cause() = %v, want %v", got, base)
+	}
+}
+
+func TestError_Root(t *testing.T) {
+	base := errors.New("root cause")
+	wrapped := Wrap(base, "context").(*Error)
+
+	if got := wrapped.Root(); got != base {
+		t.Errorf("Root() = %v, want %v", got, base)
+	}
+}
+
+func TestError_CauseMethod(t *testing.T) {
+	base := errors.New("root cause")
+	wrapped := Wrap(base, "context").(*Error)
+
+	if got := wrapped.Cause(); got != base {
+		t.Errorf("Cause() = %v, want %v", got, base)
+	}
+}
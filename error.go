@@ -7,58 +7,243 @@ import (
 	"strings"
 )
 
-// StackLine represents a single stack trace entry containing the file path
-// and line number where an error occurred or was wrapped.
+// StackLine represents a single stack trace entry. A locally captured line
+// records only the program counter of the call site and resolves file, line
+// and function lazily through runtime.CallersFrames, so capturing a line is
+// cheap even when the trace is never formatted.
 type StackLine struct {
-	// file stores the source code file path where the error occurred
-	file string
-	// line stores the line number in the source file
-	line int
+	// pc is the raw program counter returned by runtime.Callers. It is
+	// offset by one call instruction, as runtime.Callers/CallersFrames expect.
+	pc uintptr
+	// unknown marks a StackLine whose caller could not be determined, as
+	// opposed to the zero value, which represents "no frame recorded".
+	unknown bool
+	// remote holds a frame reconstructed from a textual representation
+	// (e.g. UnmarshalJSON), for which no local program counter exists.
+	remote *remoteFrame
 }
 
-// getCaller retrieves the file and line number of the calling function at a given
-// stack depth, represented by the 'skip' parameter. The information is stored in
-// a StackLine struct for consistent tracking.
+// remoteFrame is the file/line/function of a StackLine that originated in
+// another process and was reconstructed from its JSON representation.
+type remoteFrame struct {
+	file     string
+	line     int
+	function string
+}
+
+// remoteStackLine builds a StackLine from already-resolved file, line and
+// function values, bypassing local program counter resolution.
+func remoteStackLine(file string, line int, function string) StackLine {
+	return StackLine{remote: &remoteFrame{file: file, line: line, function: function}}
+}
+
+// maxStackDepth bounds how many frames a single capture records.
+const maxStackDepth = 32
+
+// getCaller captures the program counter of the calling function at a given
+// stack depth, represented by the 'skip' parameter, further offset by
+// SetSkipCallers and walked past any frame registered via RegisterHelper.
+// Resolution into file, line and function name is deferred until the
+// StackLine is formatted.
 //
 // Parameters:
 // - skip: The number of stack frames to skip when retrieving the caller.
 //
-// Returns a StackLine struct containing the file path and line number, or default
-// values if retrieval fails.
+// Returns a StackLine wrapping the captured program counter, or a StackLine
+// marked unknown if the caller could not be determined.
 func getCaller(skip int) StackLine {
-	_, file, line, ok := runtime.Caller(skip)
-	if !ok {
-		return StackLine{
-			file: "unknown",
-			line: 0,
-		}
+	pcs := capturePCs(skip + 1)
+	if len(pcs) < 1 {
+		return StackLine{unknown: true}
+	}
+	return StackLine{pc: pcs[0]}
+}
+
+// capturePCs records up to maxStackDepth raw program counters starting at
+// skip, skipping any contiguous leading frames that belong to a function
+// registered via RegisterHelper so the real caller is reported instead.
+func capturePCs(skip int) []uintptr {
+	var pcs [maxStackDepth]uintptr
+	n := runtime.Callers(skip+1+extraSkip, pcs[:])
+	if n < 1 {
+		return nil
 	}
-	return StackLine{
-		file: file,
-		line: line,
+	start := 0
+	for start < n-1 && isHelperPC(pcs[start]) {
+		start++
 	}
+	return pcs[start:n]
 }
 
-// NewStackLine creates a new StackLine capturing the current file and line number.
-// It skips 2 stack frames to get the actual caller's location.
-func NewStackLine() StackLine {
-	return getCaller(2)
+// captureStack records the bounded call stack at the given depth as a
+// "most recent call last" []StackLine: pcs are emitted by runtime.Callers
+// innermost-first, so they are reversed to put the direct caller - the wrap
+// site - last, matching the single-frame breadcrumbs addToStack appends for
+// later, explicit re-wraps. This is what lets a single New/Wrap call
+// produce a real multi-frame trace even when intermediate helpers in the
+// chain never call Wrap themselves.
+func captureStack(skip int) []StackLine {
+	pcs := capturePCs(skip + 1)
+	if len(pcs) < 1 {
+		return []StackLine{{unknown: true}}
+	}
+	lines := make([]StackLine, len(pcs))
+	for i, pc := range pcs {
+		lines[len(pcs)-1-i] = StackLine{pc: pc}
+	}
+	return lines
+}
+
+// frame resolves the StackLine's program counter into a runtime.Frame. The
+// second return value is false for the zero value StackLine, which records
+// no frame at all.
+func (s StackLine) frame() (runtime.Frame, bool) {
+	if s.pc == 0 {
+		return runtime.Frame{}, false
+	}
+	frames := runtime.CallersFrames([]uintptr{s.pc})
+	frame, _ := frames.Next()
+	return frame, true
 }
 
 // String formats the StackLine into a readable string showing file and line number
 // in the format "(file:line)".
 func (s StackLine) String() string {
-	return fmt.Sprintf("(%s:%d)", s.file, s.line)
+	return fmt.Sprintf("(%s:%d)", s.File(), s.Line())
+}
+
+// Format implements fmt.Formatter, following the per-frame verbs of
+// github.com/pkg/errors' Frame: %s and %v print "(file:line)", %+v
+// additionally resolves and prints the enclosing function name, %d prints
+// just the line number, and %n prints just the function name.
+func (s StackLine) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "%s %s", s.Function(), s.String())
+			return
+		}
+		fmt.Fprint(f, s.String())
+	case 's':
+		fmt.Fprint(f, s.String())
+	case 'd':
+		fmt.Fprintf(f, "%d", s.Line())
+	case 'n':
+		fmt.Fprint(f, s.Function())
+	}
 }
 
-// File returns the file path stored in the StackLine.
+// File returns the source file path of the StackLine, or "unknown" if the
+// caller could not be determined, or "" for the zero value.
 func (s StackLine) File() string {
-	return s.file
+	if s.remote != nil {
+		return s.remote.file
+	}
+	if s.unknown {
+		return "unknown"
+	}
+	frame, ok := s.frame()
+	if !ok {
+		return ""
+	}
+	return frame.File
 }
 
-// Line returns the line number stored in the StackLine.
+// Line returns the line number of the StackLine, or 0 if it is unknown or
+// the zero value.
 func (s StackLine) Line() int {
-	return s.line
+	if s.remote != nil {
+		return s.remote.line
+	}
+	if s.unknown {
+		return 0
+	}
+	frame, ok := s.frame()
+	if !ok {
+		return 0
+	}
+	return frame.Line
+}
+
+// Function returns the name of the function enclosing the StackLine, or
+// "unknown"/"" under the same conditions as File.
+func (s StackLine) Function() string {
+	if s.remote != nil {
+		return s.remote.function
+	}
+	if s.unknown {
+		return "unknown"
+	}
+	frame, ok := s.frame()
+	if !ok {
+		return ""
+	}
+	return frame.Function
+}
+
+// NewStackLine creates a new StackLine capturing the current file and line number.
+// It skips 2 stack frames to get the actual caller's location.
+func NewStackLine() StackLine {
+	return getCaller(2)
+}
+
+// StackTrace is an ordered sequence of StackLine entries, most recent call
+// last, implementing fmt.Formatter so callers can opt into %+v for a
+// function-qualified trace or use %s/%v for the compact "file:line" form.
+//
+// Note this is not github.com/pkg/errors' errors.StackTrace, and *Error does
+// not implement that package's StackTracer interface, so log aggregators
+// built against it (Sentry, Rollbar, and similar) will not pick up an
+// *Error's trace via that exact type assertion - ers does not take on the
+// dependency itself. PCs bridges the gap: it exposes the same raw,
+// runtime.Callers-offset program counters pkg/errors' Frame wraps, so a
+// caller can convert in a few lines, e.g.
+//
+//	frames := make(errors.StackTrace, 0, len(trace))
+//	for _, pc := range trace.PCs() {
+//		frames = append(frames, errors.Frame(pc))
+//	}
+type StackTrace []StackLine
+
+// PCs returns the raw program counters backing st, in st's own order (most
+// recent call last, the reverse of runtime.Callers' order) and in the
+// representation runtime.Callers and github.com/pkg/errors' Frame both use.
+// A remote frame (reconstructed via UnmarshalJSON) or the zero-value
+// StackLine contributes 0, since neither carries a local program counter.
+func (st StackTrace) PCs() []uintptr {
+	pcs := make([]uintptr, len(st))
+	for i, line := range st {
+		pcs[i] = line.pc
+	}
+	return pcs
+}
+
+// String renders the trace with each line prefixed by an arrow "->".
+func (st StackTrace) String() string {
+	lines := make([]string, len(st))
+	for i, line := range st {
+		lines[i] = fmt.Sprintf("-> %s", line.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Format implements fmt.Formatter: %s and %v print the compact form, %+v
+// additionally resolves and prints each frame's function name.
+func (st StackTrace) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			lines := make([]string, len(st))
+			for i, line := range st {
+				lines[i] = fmt.Sprintf("-> %+v", line)
+			}
+			fmt.Fprint(f, strings.Join(lines, "\n"))
+			return
+		}
+		fmt.Fprint(f, st.String())
+	case 's':
+		fmt.Fprint(f, st.String())
+	}
 }
 
 // Error implements the error interface and provides enhanced error handling
@@ -71,7 +256,9 @@ type Error struct {
 	stackTrace []StackLine
 	// contexts stores additional context messages added during error wrapping
 	contexts []string
-	// Maybe add error type and error code later
+	// kind classifies the error into a comparable category; the zero value
+	// means no kind was assigned
+	kind ErrorKind
 }
 
 // Error returns a formatted string containing the original error message,
@@ -100,14 +287,31 @@ func (e *Error) Unwrap() error {
 	return e.error
 }
 
-// StackTrace formats the complete stack trace into a readable string,
-// with each line prefixed by an arrow "->".
-func (e *Error) StackTrace() string {
-	trace := ""
-	for _, line := range e.stackTrace {
-		trace += fmt.Sprintf("-> %s\n", line.String())
+// Format implements fmt.Formatter, following the convention popularized by
+// github.com/pkg/errors: %s and %v print only the underlying error message,
+// keeping structured log lines compact, %q prints that message quoted, and
+// %+v opts into the verbose form - message, contexts and the full stack
+// trace, i.e. the same output as Error().
+func (e *Error) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprint(f, e.Error())
+			return
+		}
+		fmt.Fprint(f, e.error.Error())
+	case 's':
+		fmt.Fprint(f, e.error.Error())
+	case 'q':
+		fmt.Fprintf(f, "%q", e.error.Error())
 	}
-	return strings.TrimSuffix(trace, "\n")
+}
+
+// StackTrace returns the complete stack trace as a StackTrace, which
+// implements fmt.Stringer and fmt.Formatter for both the compact and the
+// function-qualified (%+v) forms.
+func (e *Error) StackTrace() StackTrace {
+	return StackTrace(e.stackTrace)
 }
 
 // Stack returns the complete stack trace as a slice of StackLine entries,
@@ -116,6 +320,12 @@ func (e *Error) Stack() []StackLine {
 	return e.stackTrace
 }
 
+// addToStack appends a StackLine to the error's stack trace, extending it
+// in place so repeated wraps of the same *Error accumulate one entry per call.
+func (e *Error) addToStack(stack StackLine) {
+	e.stackTrace = append(e.stackTrace, stack)
+}
+
 // AddContext appends one or more context messages to the error's additional information.
 // Messages are stored in order and displayed most recent last.
 func (e *Error) AddContext(contexts ...string) {
@@ -16,13 +16,10 @@ import (
 //
 // Returns an Error struct containing the formatted message and current stack location.
 func New(fmessage string, formatTags ...any) error {
-	stack := getCaller(2)
 	return &Error{
-		error: fmt.Errorf(fmessage, formatTags...),
-		stackTrace: []StackLine{
-			stack,
-		},
-		contexts: []string{},
+		error:      fmt.Errorf(fmessage, formatTags...),
+		stackTrace: captureStack(2),
+		contexts:   []string{},
 	}
 }
 
@@ -41,19 +38,61 @@ func Wrap(err error, details ...string) error {
 	if err == nil {
 		return nil
 	}
-	stack := getCaller(2)
 	switch err := err.(type) {
 	case *Error:
-		err.addToStack(stack)
+		err.addToStack(getCaller(2))
 		err.AddContext(details...)
 		return err
 	default:
 		return &Error{
-			error: err,
-			stackTrace: []StackLine{
-				stack,
-			},
-			contexts: details,
+			error:      err,
+			stackTrace: captureStack(2),
+			contexts:   details,
+		}
+	}
+}
+
+// Newk creates a new Error like New, tagged with kind so it can later be
+// classified via errors.Is(err, kind) or Kind(err).
+//
+// Parameters:
+// - kind: The ErrorKind to tag the new error with
+// - fmessage: The error message format string
+// - formatTags: Optional formatting arguments for the message
+func Newk(kind ErrorKind, fmessage string, formatTags ...any) error {
+	return &Error{
+		error:      fmt.Errorf(fmessage, formatTags...),
+		stackTrace: captureStack(2),
+		contexts:   []string{},
+		kind:       kind,
+	}
+}
+
+// Wrapk wraps an error like Wrap, tagging it with kind. If err is already an
+// Error, its kind is overwritten with the new value.
+//
+// Parameters:
+// - err: The existing error to wrap
+// - kind: The ErrorKind to tag the wrapped error with
+// - details: Variable number of strings providing additional context
+//
+// Returns nil if the input error is nil.
+func Wrapk(err error, kind ErrorKind, details ...string) error {
+	if err == nil {
+		return nil
+	}
+	switch err := err.(type) {
+	case *Error:
+		err.addToStack(getCaller(2))
+		err.AddContext(details...)
+		err.kind = kind
+		return err
+	default:
+		return &Error{
+			error:      err,
+			stackTrace: captureStack(2),
+			contexts:   details,
+			kind:       kind,
 		}
 	}
 }
@@ -72,18 +111,15 @@ func Wrapf(err error, fmessage string, formatTags ...any) error {
 	if err == nil {
 		return nil
 	}
-	stack := getCaller(2)
 	switch err := err.(type) {
 	case *Error:
-		err.addToStack(stack)
+		err.addToStack(getCaller(2))
 		err.AddContext(fmt.Sprintf(fmessage, formatTags...))
 		return err
 	default:
 		return &Error{
-			error: err,
-			stackTrace: []StackLine{
-				stack,
-			},
+			error:      err,
+			stackTrace: captureStack(2),
 			contexts: []string{
 				fmt.Sprintf(fmessage, formatTags...),
 			},
@@ -207,9 +207,12 @@ func TestStackLine(t *testing.T) {
 		t.Error("StackLine collection failed, stack should not be empty")
 	}
 
+	// The wrap site - New's own caller - is the last frame, per the
+	// "most recent call last" convention.
 	stack := ersErr.Stack()
-	if !strings.Contains(stack[0].String(), ".go") {
-		t.Errorf("StackLine String() = %v, should contain .go file extension", stack[0])
+	last := stack[len(stack)-1]
+	if !strings.Contains(last.String(), ".go") {
+		t.Errorf("StackLine String() = %v, should contain .go file extension", last)
 	}
 }
 
@@ -230,12 +233,13 @@ func TestStackLine_FileAndLine(t *testing.T) {
 	err := New("test")
 	ersErr := err.(*Error)
 	stack := ersErr.Stack()
+	last := stack[len(stack)-1]
 
-	if stack[0].File() == "" {
+	if last.File() == "" {
 		t.Error("StackLine File() should return non-empty string")
 	}
 
-	if stack[0].Line() <= 0 {
+	if last.Line() <= 0 {
 		t.Error("StackLine Line() should return positive line number")
 	}
 }
@@ -274,9 +278,12 @@ func TestDeepWrapping(t *testing.T) {
 		}
 	}
 
+	// base captures a bounded multi-frame stack rather than a single line, so
+	// the total only has a lower bound: one frame per explicit re-wrap on top
+	// of however many frames New's own capture produced.
 	ersErr := level4.(*Error)
-	if len(ersErr.Stack()) != 5 {
-		t.Errorf("Expected 5 stack frames, got %d", len(ersErr.Stack()))
+	if len(ersErr.Stack()) < 5 {
+		t.Errorf("Expected at least 5 stack frames, got %d", len(ersErr.Stack()))
 	}
 }
 
@@ -285,14 +292,18 @@ func TestStackTraceOrder(t *testing.T) {
 	wrapped := Wrap(base, "second")
 	ersErr := wrapped.(*Error)
 
-	if len(ersErr.Stack()) != 2 {
-		t.Fatal("Expected exactly 2 stack frames")
+	stack := ersErr.Stack()
+	if len(stack) < 2 {
+		t.Fatal("Expected at least 2 stack frames")
 	}
 
-	firstFrame := ersErr.Stack()[0].String()
-	secondFrame := ersErr.Stack()[1].String()
+	// The last frame is the explicit re-wrap breadcrumb added by Wrap; the one
+	// before it is the innermost frame of base's own captured stack - they
+	// must come from different call sites.
+	lastFrame := stack[len(stack)-1].String()
+	secondToLastFrame := stack[len(stack)-2].String()
 
-	if firstFrame == secondFrame {
+	if lastFrame == secondToLastFrame {
 		t.Error("Stack frames should be different")
 	}
 }
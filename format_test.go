@@ -0,0 +1,39 @@
+package ers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestError_Format(t *testing.T) {
+	wrapped := Wrap(fmt.Errorf("base error"), "context").(*Error)
+
+	if got := fmt.Sprintf("%s", wrapped); got != "base error" {
+		t.Errorf("%%s = %q, want %q", got, "base error")
+	}
+	if got := fmt.Sprintf("%v", wrapped); got != "base error" {
+		t.Errorf("%%v = %q, want %q", got, "base error")
+	}
+	if got := fmt.Sprintf("%q", wrapped); got != `"base error"` {
+		t.Errorf("%%q = %q, want %q", got, `"base error"`)
+	}
+
+	full := fmt.Sprintf("%+v", wrapped)
+	for _, want := range []string{"base error", "context", "stack trace"} {
+		if !strings.Contains(full, want) {
+			t.Errorf("%%+v = %q, should contain %q", full, want)
+		}
+	}
+}
+
+func TestStackLine_Format(t *testing.T) {
+	stack := NewStackLine()
+
+	if got := fmt.Sprintf("%s", stack); !strings.Contains(got, ".go:") {
+		t.Errorf("%%s = %q, should contain .go:", got)
+	}
+	if got := fmt.Sprintf("%+v", stack); !strings.Contains(got, "TestStackLine_Format") {
+		t.Errorf("%%+v = %q, should contain enclosing function name", got)
+	}
+}
@@ -0,0 +1,111 @@
+package ers
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"log/slog"
+)
+
+// stackLineJSON is the wire representation of a single StackLine.
+type stackLineJSON struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Function string `json:"function"`
+}
+
+// errorJSON is the wire representation of an Error, used by both
+// MarshalJSON and UnmarshalJSON.
+type errorJSON struct {
+	Message  string          `json:"message"`
+	Contexts []string        `json:"contexts,omitempty"`
+	Stack    []stackLineJSON `json:"stack,omitempty"`
+	Cause    json.RawMessage `json:"cause,omitempty"`
+}
+
+// MarshalJSON renders e as a structured document with its message,
+// contexts, stack trace and, if e's wrapped error unwraps to something that
+// also marshals to JSON (e.g. a *Error reached through a stdlib %w chain),
+// its cause - recursively, so nested *Error values round-trip as nested
+// "cause" objects.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	doc := errorJSON{
+		Message:  e.error.Error(),
+		Contexts: e.contexts,
+	}
+	for _, line := range e.stackTrace {
+		doc.Stack = append(doc.Stack, stackLineJSON{
+			File:     line.File(),
+			Line:     line.Line(),
+			Function: line.Function(),
+		})
+	}
+	if cause, ok := stderrors.Unwrap(e.error).(json.Marshaler); ok {
+		if causeJSON, err := cause.MarshalJSON(); err == nil {
+			doc.Cause = causeJSON
+		}
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON reconstructs a read-only *Error from a document produced by
+// MarshalJSON. The resulting Error's Stack() returns the remote frames
+// exactly as marshaled; they cannot be re-resolved locally since they
+// carry no program counter, only the textual file/line/function already
+// captured by the origin process.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var doc errorJSON
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	e.contexts = doc.Contexts
+	e.stackTrace = make([]StackLine, len(doc.Stack))
+	for i, line := range doc.Stack {
+		e.stackTrace[i] = remoteStackLine(line.File, line.Line, line.Function)
+	}
+	if len(doc.Cause) > 0 {
+		cause := &Error{}
+		if err := cause.UnmarshalJSON(doc.Cause); err == nil {
+			e.error = remoteError{message: doc.Message, cause: cause}
+			return nil
+		}
+	}
+	e.error = stderrors.New(doc.Message)
+	return nil
+}
+
+// remoteError reconstructs the Error() text and Unwrap chain of a
+// round-tripped *Error's immediate wrapped error, preserving both the
+// top-level message and the cause link without assuming any concrete error
+// type existed in the originating process.
+type remoteError struct {
+	message string
+	cause   error
+}
+
+func (e remoteError) Error() string { return e.message }
+func (e remoteError) Unwrap() error { return e.cause }
+
+// LogValue implements log/slog.LogValuer, grouping e's message, contexts and
+// stack trace into a single structured attribute.
+func (e *Error) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("message", e.error.Error()),
+	}
+	if len(e.contexts) > 0 {
+		attrs = append(attrs, slog.Any("contexts", e.contexts))
+	}
+	attrs = append(attrs, slog.String("stack", e.StackTrace().String()))
+	if e.kind != (ErrorKind{}) {
+		attrs = append(attrs, slog.String("kind", e.kind.Error()))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// MarshalJSON renders a StackLine as its {file, line, function} document.
+func (s StackLine) MarshalJSON() ([]byte, error) {
+	return json.Marshal(stackLineJSON{
+		File:     s.File(),
+		Line:     s.Line(),
+		Function: s.Function(),
+	})
+}
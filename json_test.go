@@ -0,0 +1,133 @@
+package ers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestError_MarshalJSON(t *testing.T) {
+	err := Wrap(Wrap(errors.New("root cause"), "level1"), "level2").(*Error)
+
+	data, marshalErr := json.Marshal(err)
+	if marshalErr != nil {
+		t.Fatalf("MarshalJSON() error = %v", marshalErr)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("unmarshal into map failed: %v", err)
+	}
+	if doc["message"] != "root cause" {
+		t.Errorf("message = %v, want %q", doc["message"], "root cause")
+	}
+	contexts, _ := doc["contexts"].([]any)
+	if len(contexts) != 2 {
+		t.Errorf("contexts = %v, want 2 entries", doc["contexts"])
+	}
+	stack, _ := doc["stack"].([]any)
+	if len(stack) < 2 {
+		t.Errorf("stack = %v, want at least 2 entries", doc["stack"])
+	}
+}
+
+func TestError_JSONRoundTrip(t *testing.T) {
+	original := Wrap(errors.New("boom"), "context").(*Error)
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var restored Error
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if restored.error.Error() != "boom" {
+		t.Errorf("restored message = %q, want %q", restored.error.Error(), "boom")
+	}
+	if len(restored.Stack()) < 1 {
+		t.Fatalf("expected at least 1 restored stack frame, got %d", len(restored.Stack()))
+	}
+	// The wrap site is the last frame, per the "most recent call last"
+	// convention; earlier frames may be runtime/testing harness frames.
+	last := restored.Stack()[len(restored.Stack())-1]
+	if !strings.Contains(last.File(), ".go") {
+		t.Errorf("restored frame file = %q, should contain .go", last.File())
+	}
+}
+
+func TestError_MarshalJSON_NestedCause(t *testing.T) {
+	root := New("root cause").(*Error)
+	// %w over an *Error is the realistic way a *Error ends up nested one
+	// level deeper than e.error itself, e.g. when crossing a package that
+	// only knows stdlib wrapping.
+	err := Wrap(fmt.Errorf("during x: %w", root), "outer context").(*Error)
+
+	data, marshalErr := json.Marshal(err)
+	if marshalErr != nil {
+		t.Fatalf("MarshalJSON() error = %v", marshalErr)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("unmarshal into map failed: %v", err)
+	}
+	cause, ok := doc["cause"].(map[string]any)
+	if !ok {
+		t.Fatalf("doc = %v, want a \"cause\" object", doc)
+	}
+	if cause["message"] != "root cause" {
+		t.Errorf("cause message = %v, want %q", cause["message"], "root cause")
+	}
+}
+
+func TestError_JSONRoundTrip_NestedCause(t *testing.T) {
+	root := New("root cause").(*Error)
+	original := Wrap(fmt.Errorf("during x: %w", root), "outer context").(*Error)
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var restored Error
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	wantMessage := original.error.Error()
+	if restored.error.Error() != wantMessage {
+		t.Errorf("restored message = %q, want %q", restored.error.Error(), wantMessage)
+	}
+
+	cause := errors.Unwrap(restored.error)
+	if cause == nil {
+		t.Fatal("restored error should preserve its cause chain")
+	}
+	if !strings.Contains(cause.Error(), "root cause") {
+		t.Errorf("restored cause message = %q, should contain %q", cause.Error(), "root cause")
+	}
+}
+
+func TestError_LogValue(t *testing.T) {
+	err := Wrap(errors.New("boom"), "context").(*Error)
+
+	value := err.LogValue()
+	if value.Kind().String() != "Group" {
+		t.Fatalf("LogValue().Kind() = %v, want Group", value.Kind())
+	}
+
+	found := false
+	for _, attr := range value.Group() {
+		if attr.Key == "message" && attr.Value.String() == "boom" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("LogValue() group should contain message=boom")
+	}
+}
@@ -0,0 +1,63 @@
+package ers
+
+import "errors"
+
+// ErrorKind classifies an Error into a broad, comparable category (e.g. "not
+// found", "permission denied") so callers can branch on kind instead of
+// string-matching a message. It implements the error interface, so a kind
+// can itself be used as the target of errors.Is.
+type ErrorKind struct {
+	name string
+}
+
+// NewKind creates a new ErrorKind identified by name. Kinds created with
+// different names are never equal, so each call site should keep the
+// returned value around as a sentinel, the same way stdlib errors.New is used.
+func NewKind(name string) ErrorKind {
+	return ErrorKind{name: name}
+}
+
+// Error returns the kind's name, satisfying the error interface.
+func (k ErrorKind) Error() string {
+	return k.name
+}
+
+// WithKind sets e's kind and returns e, allowing the call to be chained
+// onto New/Wrap.
+func (e *Error) WithKind(kind ErrorKind) *Error {
+	e.kind = kind
+	return e
+}
+
+// Is reports whether target is the ErrorKind e was tagged with, enabling
+// errors.Is(err, ErrNotFound)-style classification across wraps.
+func (e *Error) Is(target error) bool {
+	kind, ok := target.(ErrorKind)
+	if !ok {
+		return false
+	}
+	return e.kind == kind
+}
+
+// As populates target, which must be a *ErrorKind, with e's kind, enabling
+// errors.As(err, &kind)-style extraction.
+func (e *Error) As(target any) bool {
+	kind, ok := target.(*ErrorKind)
+	if !ok {
+		return false
+	}
+	*kind = e.kind
+	return true
+}
+
+// Kind walks err's wrap chain and returns the first ErrorKind found, or the
+// zero ErrorKind if none of the chain was tagged with WithKind/Newk/Wrapk.
+func Kind(err error) ErrorKind {
+	for err != nil {
+		if e, ok := err.(*Error); ok && e.kind != (ErrorKind{}) {
+			return e.kind
+		}
+		err = errors.Unwrap(err)
+	}
+	return ErrorKind{}
+}
@@ -0,0 +1,69 @@
+package ers
+
+import (
+	"errors"
+	"testing"
+)
+
+var errNotFoundTest = NewKind("not_found")
+var errPermissionTest = NewKind("permission_denied")
+
+func TestNewk(t *testing.T) {
+	err := Newk(errNotFoundTest, "user %d missing", 7)
+
+	if !errors.Is(err, errNotFoundTest) {
+		t.Error("errors.Is() should match the tagged kind")
+	}
+	if errors.Is(err, errPermissionTest) {
+		t.Error("errors.Is() should not match an unrelated kind")
+	}
+	if Kind(err) != errNotFoundTest {
+		t.Errorf("Kind() = %v, want %v", Kind(err), errNotFoundTest)
+	}
+}
+
+func TestWrapk(t *testing.T) {
+	base := errors.New("lookup failed")
+	wrapped := Wrapk(base, errNotFoundTest, "context")
+
+	if !errors.Is(wrapped, errNotFoundTest) {
+		t.Error("Wrapk() should tag the wrapped error with the given kind")
+	}
+	if !errors.Is(wrapped, base) {
+		t.Error("Wrapk() should preserve the original wrap chain")
+	}
+}
+
+func TestWrapk_NilError(t *testing.T) {
+	if Wrapk(nil, errNotFoundTest, "context") != nil {
+		t.Error("Wrapk(nil) should return nil")
+	}
+}
+
+func TestWithKind(t *testing.T) {
+	err := New("boom").(*Error).WithKind(errPermissionTest)
+
+	if Kind(err) != errPermissionTest {
+		t.Errorf("Kind() = %v, want %v", Kind(err), errPermissionTest)
+	}
+}
+
+func TestKind_Untagged(t *testing.T) {
+	err := New("boom")
+
+	if Kind(err) != (ErrorKind{}) {
+		t.Errorf("Kind() = %v, want zero value", Kind(err))
+	}
+}
+
+func TestError_As(t *testing.T) {
+	err := Newk(errNotFoundTest, "missing")
+
+	var kind ErrorKind
+	if !errors.As(err, &kind) {
+		t.Fatal("errors.As() should succeed for *ErrorKind targets")
+	}
+	if kind != errNotFoundTest {
+		t.Errorf("As() populated kind = %v, want %v", kind, errNotFoundTest)
+	}
+}
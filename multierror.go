@@ -0,0 +1,85 @@
+package ers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultiError aggregates multiple errors, each retaining its own stack trace
+// and contexts. It implements Unwrap() []error, so errors.Is/As traverse
+// every branch - useful for concurrent pipelines (errgroup-style) where
+// several goroutines can fail and no single error should be picked over
+// the rest.
+type MultiError struct {
+	errs []error
+}
+
+// Join combines errs into a single error. Nil errors are discarded, and
+// *MultiError arguments are flattened rather than nested. Join returns nil
+// if errs is empty or every element is nil, so Join(nil, nil) == nil.
+func Join(errs ...error) error {
+	var merged MultiError
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if m, ok := err.(*MultiError); ok {
+			merged.errs = append(merged.errs, m.errs...)
+			continue
+		}
+		merged.errs = append(merged.errs, err)
+	}
+	if len(merged.errs) == 0 {
+		return nil
+	}
+	return &merged
+}
+
+// Append adds errs to dst, returning a *MultiError, or nil if dst and every
+// element of errs are nil. dst may be nil, a plain
+// error, or an existing *MultiError, in which case its branches are
+// extended rather than nested. It is meant for iterative accumulation, e.g.
+// inside a loop collecting per-item failures.
+func Append(dst error, errs ...error) error {
+	if dst == nil {
+		return Join(errs...)
+	}
+	if m, ok := dst.(*MultiError); ok {
+		return Join(append(m.errs, errs...)...)
+	}
+	return Join(append([]error{dst}, errs...)...)
+}
+
+// Unwrap returns m's branches, enabling errors.Is/As to traverse all of
+// them per Go 1.20's multi-error convention.
+func (m *MultiError) Unwrap() []error {
+	return m.errs
+}
+
+// Errors returns the branches aggregated into m, allowing programmatic
+// access similar to Error.Stack/Error.Contexts.
+func (m *MultiError) Errors() []error {
+	return m.errs
+}
+
+// Error renders m as a numbered list, with each branch's own message
+// indented beneath its entry - including its stack trace, if the branch is
+// an *Error.
+func (m *MultiError) Error() string {
+	entries := make([]string, len(m.errs))
+	for i, err := range m.errs {
+		entries[i] = fmt.Sprintf("%d) %s", i+1, indentLines(err.Error()))
+	}
+	return strings.Join(entries, "\n")
+}
+
+// indentLines indents every line after the first by one tab, so multi-line
+// error text (e.g. an *Error's stack trace) nests visibly beneath its
+// numbered entry.
+func indentLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i := 1; i < len(lines); i++ {
+		lines[i] = "\t" + lines[i]
+	}
+	return strings.Join(lines, "\n")
+}
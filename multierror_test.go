@@ -0,0 +1,74 @@
+package ers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	err1 := errors.New("first")
+	err2 := errors.New("second")
+
+	joined := Join(err1, err2)
+	if joined == nil {
+		t.Fatal("Join() = nil, want error")
+	}
+	if !errors.Is(joined, err1) || !errors.Is(joined, err2) {
+		t.Error("errors.Is() should find both branches")
+	}
+
+	errStr := joined.Error()
+	for _, want := range []string{"1) first", "2) second"} {
+		if !strings.Contains(errStr, want) {
+			t.Errorf("Error() = %q, should contain %q", errStr, want)
+		}
+	}
+}
+
+func TestJoin_NilsOnly(t *testing.T) {
+	if Join(nil, nil) != nil {
+		t.Error("Join(nil, nil) should be nil")
+	}
+	if Join() != nil {
+		t.Error("Join() should be nil")
+	}
+}
+
+func TestJoin_SkipsNils(t *testing.T) {
+	err1 := errors.New("first")
+
+	joined := Join(nil, err1, nil).(*MultiError)
+	if len(joined.Errors()) != 1 {
+		t.Errorf("expected 1 branch, got %d", len(joined.Errors()))
+	}
+}
+
+func TestJoin_FlattensMultiError(t *testing.T) {
+	inner := Join(errors.New("a"), errors.New("b"))
+	joined := Join(inner, errors.New("c")).(*MultiError)
+
+	if len(joined.Errors()) != 3 {
+		t.Errorf("expected 3 flattened branches, got %d", len(joined.Errors()))
+	}
+}
+
+func TestAppend(t *testing.T) {
+	var err error
+	err = Append(err, errors.New("first"))
+	err = Append(err, errors.New("second"))
+
+	merged := err.(*MultiError)
+	if len(merged.Errors()) != 2 {
+		t.Errorf("expected 2 accumulated errors, got %d", len(merged.Errors()))
+	}
+}
+
+func TestMultiError_WithErsError(t *testing.T) {
+	joined := Join(New("ers error"), errors.New("plain error")).(*MultiError)
+
+	errStr := joined.Error()
+	if !strings.Contains(errStr, "stack trace") {
+		t.Error("Error() should indent and include the *Error branch's stack trace")
+	}
+}
@@ -0,0 +1,85 @@
+package ers
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// extraSkip is added to every getCaller call, on top of the package's own
+// fixed offsets, letting callers attribute captured frames to code further
+// up the stack. Configured via SetSkipCallers.
+var extraSkip int
+
+// SetSkipCallers configures an additional number of stack frames to skip
+// when capturing a StackLine, for every subsequent New/Wrap/Wrapf/Newk/Wrapk
+// call in the process. It is meant for packages that wrap ers behind a
+// thin helper (e.g. a project-specific errorf) and want the reported
+// caller to be the helper's caller rather than the helper itself.
+func SetSkipCallers(n int) {
+	extraSkip = n
+}
+
+// helpers holds the names of functions registered via RegisterHelper, so
+// getCaller can skip over them when resolving a caller. Names, rather than
+// entry program counters, are compared because reflect.Value.Pointer() on a
+// func value is not guaranteed to resolve to the same address FuncForPC
+// reports for a pc captured mid-call.
+var helpers sync.Map
+
+// RegisterHelper marks fn as a helper function that wraps ers's
+// constructors. Frames belonging to fn are skipped automatically when
+// resolving a caller, so its own callers don't need to hand-tune a skip
+// count with NewWithSkip/WrapWithSkip.
+func RegisterHelper(fn any) {
+	pc := reflect.ValueOf(fn).Pointer()
+	if f := runtime.FuncForPC(pc); f != nil {
+		helpers.Store(f.Name(), struct{}{})
+	}
+}
+
+// isHelperPC reports whether pc, a raw runtime.Callers program counter,
+// falls within a function registered via RegisterHelper.
+func isHelperPC(pc uintptr) bool {
+	fn := runtime.FuncForPC(pc - 1)
+	if fn == nil {
+		return false
+	}
+	_, ok := helpers.Load(fn.Name())
+	return ok
+}
+
+// NewWithSkip creates a new Error like New, but skips an additional number
+// of stack frames before recording the caller, for use by helper functions
+// that wrap New and want the reported origin to be their own caller.
+func NewWithSkip(skip int, fmessage string, formatTags ...any) error {
+	return &Error{
+		error:      fmt.Errorf(fmessage, formatTags...),
+		stackTrace: captureStack(2 + skip),
+		contexts:   []string{},
+	}
+}
+
+// WrapWithSkip wraps an error like Wrap, but skips an additional number of
+// stack frames before recording the caller, for use by helper functions
+// that wrap Wrap and want the reported origin to be their own caller.
+//
+// Returns nil if the input error is nil.
+func WrapWithSkip(skip int, err error, details ...string) error {
+	if err == nil {
+		return nil
+	}
+	switch err := err.(type) {
+	case *Error:
+		err.addToStack(getCaller(2 + skip))
+		err.AddContext(details...)
+		return err
+	default:
+		return &Error{
+			error:      err,
+			stackTrace: captureStack(2 + skip),
+			contexts:   details,
+		}
+	}
+}
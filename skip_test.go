@@ -0,0 +1,84 @@
+package ers
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestNewWithSkip(t *testing.T) {
+	err := helperNew("boom").(*Error)
+
+	last := err.Stack()[len(err.Stack())-1]
+	if strings.Contains(last.Function(), "helperNew") {
+		t.Errorf("NewWithSkip should attribute the frame to the caller, not the helper: %s", last.Function())
+	}
+}
+
+// helperNew mimics a project-specific wrapper around New that should be
+// transparent to the reported caller.
+func helperNew(msg string) error {
+	return NewWithSkip(1, msg)
+}
+
+func TestWrapWithSkip(t *testing.T) {
+	base := stdlibErr("root")
+	err := helperWrap(base).(*Error)
+
+	last := err.Stack()[len(err.Stack())-1]
+	if strings.Contains(last.Function(), "helperWrap") {
+		t.Errorf("WrapWithSkip should attribute the frame to the caller, not the helper: %s", last.Function())
+	}
+}
+
+func helperWrap(err error) error {
+	return WrapWithSkip(1, err, "context")
+}
+
+func TestWrapWithSkip_NilError(t *testing.T) {
+	if WrapWithSkip(1, nil, "context") != nil {
+		t.Error("WrapWithSkip(nil) should return nil")
+	}
+}
+
+func TestRegisterHelper(t *testing.T) {
+	RegisterHelper(registeredHelper)
+	name := runtime.FuncForPC(reflect.ValueOf(registeredHelper).Pointer()).Name()
+	defer helpers.Delete(name)
+
+	err := registeredHelper("boom").(*Error)
+
+	// The wrap site - registeredHelper's own caller - is the last frame, per
+	// the "most recent call last" convention.
+	last := err.Stack()[len(err.Stack())-1]
+	if strings.Contains(last.Function(), "registeredHelper") {
+		t.Errorf("RegisterHelper should make the registered frame transparent: %s", last.Function())
+	}
+	if !strings.Contains(last.Function(), "TestRegisterHelper") {
+		t.Errorf("RegisterHelper should attribute the frame to the caller, got: %s", last.Function())
+	}
+}
+
+func registeredHelper(msg string) error {
+	return New(msg)
+}
+
+func TestSetSkipCallers(t *testing.T) {
+	SetSkipCallers(1)
+	defer SetSkipCallers(0)
+
+	err := registeredHelper("boom").(*Error)
+
+	last := err.Stack()[len(err.Stack())-1]
+	if strings.Contains(last.Function(), "registeredHelper") {
+		t.Errorf("SetSkipCallers should skip past the extra frame: %s", last.Function())
+	}
+	if !strings.Contains(last.Function(), "TestSetSkipCallers") {
+		t.Errorf("SetSkipCallers should attribute the frame to the caller, got: %s", last.Function())
+	}
+}
+
+type stdlibErr string
+
+func (e stdlibErr) Error() string { return string(e) }
@@ -0,0 +1,59 @@
+package ers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStackLine_Function(t *testing.T) {
+	stack := NewStackLine()
+
+	if !strings.Contains(stack.Function(), "TestStackLine_Function") {
+		t.Errorf("Function() = %q, should contain enclosing test name", stack.Function())
+	}
+}
+
+func TestStackLine_UnknownFunction(t *testing.T) {
+	stack := getCaller(9999)
+
+	if stack.Function() != "unknown" {
+		t.Errorf("Function() = %q, want %q", stack.Function(), "unknown")
+	}
+}
+
+func TestError_StackTrace(t *testing.T) {
+	err := New("boom").(*Error)
+	trace := err.StackTrace()
+
+	if len(trace) < 1 {
+		t.Fatalf("expected at least 1 frame, got %d", len(trace))
+	}
+	if !strings.Contains(trace.String(), ".go:") {
+		t.Errorf("StackTrace.String() = %q, should contain .go:", trace.String())
+	}
+}
+
+func TestStackTrace_PCs(t *testing.T) {
+	err := New("boom").(*Error)
+	trace := err.StackTrace()
+
+	pcs := trace.PCs()
+	if len(pcs) != len(trace) {
+		t.Fatalf("PCs() returned %d entries, want %d", len(pcs), len(trace))
+	}
+	for i, pc := range pcs {
+		if pc == 0 {
+			t.Errorf("PCs()[%d] = 0, want a non-zero program counter", i)
+		}
+	}
+}
+
+func TestStackTrace_FormatVerbose(t *testing.T) {
+	err := New("boom").(*Error)
+
+	full := fmt.Sprintf("%+v", err.StackTrace())
+	if !strings.Contains(full, "TestStackTrace_FormatVerbose") {
+		t.Errorf("%%+v StackTrace = %q, should contain enclosing function name", full)
+	}
+}